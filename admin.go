@@ -0,0 +1,207 @@
+package cloudinary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Admin is Cloudinary's admin API: listing, searching and auditing
+	// already-uploaded assets. Unlike upload and delete, which sign
+	// requests with the SHA1 scheme, the admin API authenticates with
+	// HTTP Basic Auth using the api key and secret.
+	Admin struct {
+		c *Cloudinary
+	}
+
+	// ListOptions filters Admin.ListResources.
+	ListOptions struct {
+		// ResourceType is image, video or raw. Defaults to ImageType.
+		ResourceType UploadType
+		// Type is the delivery type: upload, private or authenticated.
+		// Defaults to "upload".
+		Type DeliveryType
+		// Prefix, if set, restricts results to public ids with this prefix.
+		Prefix string
+		// Tag, if set, restricts results to assets carrying this tag,
+		// instead of listing by prefix.
+		Tag string
+		// MaxResults caps the page size. Cloudinary defaults to 10, max 500.
+		MaxResults int
+		// NextCursor resumes listing from a prior ListResult.NextCursor.
+		NextCursor string
+	}
+
+	// Resource describes one asset as returned by the admin API.
+	Resource struct {
+		PublicID     string `json:"public_id"`
+		Format       string `json:"format"`
+		Version      uint   `json:"version"`
+		ResourceType string `json:"resource_type"`
+		Type         string `json:"type"`
+		CreatedAt    string `json:"created_at"`
+		Bytes        int    `json:"bytes"`
+		Width        int    `json:"width"`
+		Height       int    `json:"height"`
+		URL          string `json:"url"`
+		SecureURL    string `json:"secure_url"`
+	}
+
+	// ListResult is the response of Admin.ListResources.
+	ListResult struct {
+		Resources  []Resource `json:"resources"`
+		NextCursor string     `json:"next_cursor"`
+	}
+
+	// SearchOptions controls pagination for Admin.Search.
+	SearchOptions struct {
+		// MaxResults caps the page size. Cloudinary defaults to 50, max 500.
+		MaxResults int
+		// NextCursor resumes a search from a prior SearchResult.NextCursor.
+		NextCursor string
+	}
+
+	// SearchResult is the response of Admin.Search.
+	SearchResult struct {
+		TotalCount int        `json:"total_count"`
+		Resources  []Resource `json:"resources"`
+		NextCursor string     `json:"next_cursor"`
+	}
+
+	// UsageCounter is one metered quantity in UsageStats, e.g. bandwidth or
+	// storage for the current billing cycle.
+	UsageCounter struct {
+		Usage       int64   `json:"usage"`
+		Limit       int64   `json:"limit"`
+		UsedPercent float64 `json:"used_percent"`
+	}
+
+	// UsageStats is the response of Admin.Usage.
+	UsageStats struct {
+		Plan             string       `json:"plan"`
+		LastUpdated      string       `json:"last_updated"`
+		Transformations  UsageCounter `json:"transformations"`
+		Objects          UsageCounter `json:"objects"`
+		Bandwidth        UsageCounter `json:"bandwidth"`
+		Storage          UsageCounter `json:"storage"`
+		Requests         int64        `json:"requests"`
+		Resources        int64        `json:"resources"`
+		DerivedResources int64        `json:"derived_resources"`
+	}
+)
+
+// Admin returns the admin API for c, for listing, searching and auditing
+// already-uploaded assets.
+func (c *Cloudinary) Admin() *Admin {
+	return &Admin{c: c}
+}
+
+// ListResources lists uploaded assets matching opts, newest first.
+func (a *Admin) ListResources(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	resourceType := opts.ResourceType
+	if resourceType == "" {
+		resourceType = ImageType
+	}
+
+	deliveryType := opts.Type
+	if deliveryType == "" {
+		deliveryType = UploadDelivery
+	}
+
+	uri := fmt.Sprintf("https://api.cloudinary.com/v1_1/%s/resources/%s/%s", a.c.cloudName, resourceType, deliveryType)
+	if opts.Tag != "" {
+		uri = fmt.Sprintf("https://api.cloudinary.com/v1_1/%s/resources/%s/tags/%s", a.c.cloudName, resourceType, opts.Tag)
+	}
+
+	q := url.Values{}
+	if opts.Prefix != "" {
+		q.Set("prefix", opts.Prefix)
+	}
+	if opts.MaxResults > 0 {
+		q.Set("max_results", strconv.Itoa(opts.MaxResults))
+	}
+	if opts.NextCursor != "" {
+		q.Set("next_cursor", opts.NextCursor)
+	}
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+
+	result := new(ListResult)
+	return result, a.c.doAdminRequest(ctx, http.MethodGet, uri, nil, result)
+}
+
+// Resource fetches the details of a single asset. deliveryType is the
+// delivery type under which the asset was uploaded (upload, private or
+// authenticated); it defaults to UploadDelivery when empty.
+func (a *Admin) Resource(ctx context.Context, publicID string, resourceType UploadType, deliveryType DeliveryType) (*Resource, error) {
+	if deliveryType == "" {
+		deliveryType = UploadDelivery
+	}
+
+	uri := fmt.Sprintf("https://api.cloudinary.com/v1_1/%s/resources/%s/%s/%s", a.c.cloudName, resourceType, deliveryType, publicID)
+
+	result := new(Resource)
+	return result, a.c.doAdminRequest(ctx, http.MethodGet, uri, nil, result)
+}
+
+// Search runs a Cloudinary search expression (Cloudinary's own query
+// syntax, e.g. "tags:summer AND resource_type:image") against the
+// account's assets.
+func (a *Admin) Search(ctx context.Context, expression string, opts SearchOptions) (*SearchResult, error) {
+	body, err := json.Marshal(struct {
+		Expression string `json:"expression"`
+		MaxResults int    `json:"max_results,omitempty"`
+		NextCursor string `json:"next_cursor,omitempty"`
+	}{expression, opts.MaxResults, opts.NextCursor})
+	if err != nil {
+		return nil, err
+	}
+
+	uri := fmt.Sprintf("https://api.cloudinary.com/v1_1/%s/resources/search", a.c.cloudName)
+
+	result := new(SearchResult)
+	return result, a.c.doAdminRequest(ctx, http.MethodPost, uri, bytes.NewReader(body), result)
+}
+
+// Usage returns the account's usage against its plan limits for the
+// current billing cycle.
+func (a *Admin) Usage(ctx context.Context) (*UsageStats, error) {
+	uri := fmt.Sprintf("https://api.cloudinary.com/v1_1/%s/usage", a.c.cloudName)
+
+	result := new(UsageStats)
+	return result, a.c.doAdminRequest(ctx, http.MethodGet, uri, nil, result)
+}
+
+// doAdminRequest issues a Basic Auth-authenticated admin API request and
+// decodes the response into result.
+func (c *Cloudinary) doAdminRequest(ctx context.Context, method, uri string, body *bytes.Reader, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = body
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, uri, reqBody)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.SetBasicAuth(c.apiKey, c.apiSecret)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return unmarshalResponse(res, result)
+}
@@ -0,0 +1,132 @@
+package cloudinary
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// roundTripFunc lets a test stub out the whole HTTP round trip without a
+// real network call.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func newTestAdmin(assertReq func(*http.Request), body string) *Admin {
+	client := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			assertReq(r)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	c := &Cloudinary{cloudName: "demo", apiKey: "apikey", apiSecret: "apisecret", httpClient: client}
+	return c.Admin()
+}
+
+func TestAdmin_ListResources(t *testing.T) {
+	a := newTestAdmin(func(r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "apikey" || pass != "apisecret" {
+			t.Errorf("missing or wrong basic auth: %v %v %v", user, pass, ok)
+		}
+		if want := "https://api.cloudinary.com/v1_1/demo/resources/image/upload"; r.URL.Scheme+"://"+r.URL.Host+r.URL.Path != want {
+			t.Errorf("url = %v, want %v", r.URL, want)
+		}
+		if got := r.URL.Query().Get("prefix"); got != "avatars/" {
+			t.Errorf("prefix = %v, want avatars/", got)
+		}
+	}, `{"resources":[{"public_id":"avatars/a"}],"next_cursor":"abc"}`)
+
+	res, err := a.ListResources(context.Background(), ListOptions{Prefix: "avatars/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Resources) != 1 || res.Resources[0].PublicID != "avatars/a" {
+		t.Errorf("Resources = %v", res.Resources)
+	}
+	if res.NextCursor != "abc" {
+		t.Errorf("NextCursor = %v, want abc", res.NextCursor)
+	}
+}
+
+func TestAdmin_ListResourcesByTag(t *testing.T) {
+	a := newTestAdmin(func(r *http.Request) {
+		if want := "/v1_1/demo/resources/image/tags/summer"; !strings.HasSuffix(r.URL.Path, want) {
+			t.Errorf("path = %v, want suffix %v", r.URL.Path, want)
+		}
+	}, `{"resources":[]}`)
+
+	if _, err := a.ListResources(context.Background(), ListOptions{Tag: "summer"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdmin_Usage(t *testing.T) {
+	a := newTestAdmin(func(r *http.Request) {
+		if want := "/v1_1/demo/usage"; r.URL.Path != want {
+			t.Errorf("path = %v, want %v", r.URL.Path, want)
+		}
+	}, `{"plan":"Free","requests":42}`)
+
+	stats, err := a.Usage(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Plan != "Free" || stats.Requests != 42 {
+		t.Errorf("Usage() = %+v", stats)
+	}
+}
+
+func TestAdmin_Resource(t *testing.T) {
+	a := newTestAdmin(func(r *http.Request) {
+		if want := "/v1_1/demo/resources/image/authenticated/avatars/a"; r.URL.Path != want {
+			t.Errorf("path = %v, want %v", r.URL.Path, want)
+		}
+	}, `{"public_id":"avatars/a","bytes":42}`)
+
+	res, err := a.Resource(context.Background(), "avatars/a", ImageType, AuthenticatedDelivery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PublicID != "avatars/a" || res.Bytes != 42 {
+		t.Errorf("Resource() = %+v", res)
+	}
+}
+
+func TestAdmin_Resource_DefaultsToUploadDelivery(t *testing.T) {
+	a := newTestAdmin(func(r *http.Request) {
+		if want := "/v1_1/demo/resources/image/upload/sample"; r.URL.Path != want {
+			t.Errorf("path = %v, want %v", r.URL.Path, want)
+		}
+	}, `{"public_id":"sample"}`)
+
+	if _, err := a.Resource(context.Background(), "sample", ImageType, ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdmin_Search(t *testing.T) {
+	a := newTestAdmin(func(r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %v, want POST", r.Method)
+		}
+		if want := "/v1_1/demo/resources/search"; r.URL.Path != want {
+			t.Errorf("path = %v, want %v", r.URL.Path, want)
+		}
+	}, `{"total_count":1,"resources":[{"public_id":"sample"}]}`)
+
+	res, err := a.Search(context.Background(), "tags:summer", SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalCount != 1 || len(res.Resources) != 1 {
+		t.Errorf("Search() = %+v", res)
+	}
+}
@@ -33,7 +33,7 @@ func TestCloudinary_Upload(t *testing.T) {
 	}
 	defer f.Close()
 
-	img, err := c.Upload(f, imagename)
+	img, err := c.UploadImage(f, imagename)
 	if err != nil {
 		t.Errorf("upload failed: %v", err)
 	}
@@ -43,7 +43,7 @@ func TestCloudinary_Upload(t *testing.T) {
 	}
 
 	// delete test image
-	if err := c.Delete(imagename); err != nil {
+	if err := c.DeleteImage(imagename); err != nil {
 		t.Errorf("image delete failed after upload: %v", err)
 	}
 }
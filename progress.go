@@ -0,0 +1,98 @@
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// UploadWithProgress uploads r like UploadImage/UploadVideo, but calls
+// progress after every chunk is written to the connection with the number
+// of bytes sent so far and the total body size, so callers can render a
+// progress bar or forward status to a client.
+func (c *Cloudinary) UploadWithProgress(r io.Reader, name string, ut UploadType, progress func(bytesSent, totalBytes int64)) (*UploadResponse, error) {
+	return c.UploadWithProgressContext(context.Background(), r, name, ut, progress)
+}
+
+// UploadWithProgressContext is UploadWithProgress with a caller-supplied
+// context, so the upload can be cancelled or bound to a deadline.
+func (c *Cloudinary) UploadWithProgressContext(ctx context.Context, r io.Reader, name string, ut UploadType, progress func(bytesSent, totalBytes int64)) (*UploadResponse, error) {
+	buf, contentType, err := c.buildUploadBody(r, name, UploadOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	total := int64(buf.Len())
+	body := &progressReader{r: buf, total: total, onRead: progress}
+
+	uri := fmt.Sprintf(baseURL, c.cloudName, ut, "upload")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.ContentLength = total
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := new(UploadResponse)
+	return result, unmarshalResponse(res, result)
+}
+
+// progressReader wraps r, reporting cumulative bytes read against total to
+// onRead after every Read call.
+type progressReader struct {
+	r      io.Reader
+	total  int64
+	read   int64
+	onRead func(read, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if p.onRead != nil {
+		p.onRead(p.read, p.total)
+	}
+	return n, err
+}
+
+// JSONEventWriter emits newline-delimited JSON progress events to W, in the
+// form {"status":"uploading","current":N,"total":T} while an upload is in
+// flight and {"status":"done","public_id":"..."} once it completes. Pass
+// Progress as the progress callback to UploadWithProgress and call Done
+// with the resulting UploadResponse.
+type JSONEventWriter struct {
+	W io.Writer
+}
+
+// NewJSONEventWriter returns a JSONEventWriter that writes events to w.
+func NewJSONEventWriter(w io.Writer) *JSONEventWriter {
+	return &JSONEventWriter{W: w}
+}
+
+// Progress writes an "uploading" event. It has the signature required by
+// UploadWithProgress's progress parameter; write failures are ignored since
+// progress reporting is best-effort and must never fail the upload.
+func (e *JSONEventWriter) Progress(current, total int64) {
+	_ = json.NewEncoder(e.W).Encode(struct {
+		Status  string `json:"status"`
+		Current int64  `json:"current"`
+		Total   int64  `json:"total"`
+	}{"uploading", current, total})
+}
+
+// Done writes a "done" event once the upload has finished.
+func (e *JSONEventWriter) Done(result *UploadResponse) {
+	_ = json.NewEncoder(e.W).Encode(struct {
+		Status   string `json:"status"`
+		PublicID string `json:"public_id"`
+	}{"done", result.PublicID})
+}
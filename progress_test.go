@@ -0,0 +1,50 @@
+package cloudinary
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestProgressReader(t *testing.T) {
+	var calls [][2]int64
+	pr := &progressReader{
+		r:     strings.NewReader("hello world"),
+		total: 11,
+		onRead: func(read, total int64) {
+			calls = append(calls, [2]int64{read, total})
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	n, err := buf.ReadFrom(pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 11 {
+		t.Errorf("read %d bytes, want 11", n)
+	}
+	if len(calls) == 0 {
+		t.Fatal("onRead was never called")
+	}
+	last := calls[len(calls)-1]
+	if last[0] != 11 || last[1] != 11 {
+		t.Errorf("last call = %v, want [11 11]", last)
+	}
+}
+
+func TestJSONEventWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	ew := NewJSONEventWriter(buf)
+
+	ew.Progress(5, 10)
+	ew.Done(&UploadResponse{PublicID: "sample"})
+
+	out := buf.String()
+	if !strings.Contains(out, `"status":"uploading"`) || !strings.Contains(out, `"current":5`) {
+		t.Errorf("missing uploading event, got %s", out)
+	}
+	if !strings.Contains(out, `"status":"done"`) || !strings.Contains(out, `"public_id":"sample"`) {
+		t.Errorf("missing done event, got %s", out)
+	}
+}
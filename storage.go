@@ -0,0 +1,41 @@
+package cloudinary
+
+import (
+	"context"
+	"io"
+)
+
+// Storage is implemented by anything that can upload, delete and build
+// delivery URLs for binary assets under Cloudinary's upload/delete/URL
+// model. Cloudinary implements it directly; see the storage subpackages
+// for S3-compatible and local filesystem backends that can be swapped in
+// without changing call sites.
+type Storage interface {
+	Upload(ctx context.Context, r io.Reader, name string, kind UploadType) (*UploadResponse, error)
+	Delete(ctx context.Context, name string, kind UploadType) error
+	URL(name string, opts URLOptions) string
+}
+
+// Upload implements Storage by delegating to the image/video upload path
+// for kind.
+func (c *Cloudinary) Upload(ctx context.Context, r io.Reader, name string, kind UploadType) (*UploadResponse, error) {
+	return c.upload(ctx, r, name, kind, UploadOptions{})
+}
+
+// Delete implements Storage by delegating to the image/video delete path
+// for kind.
+func (c *Cloudinary) Delete(ctx context.Context, name string, kind UploadType) error {
+	return c.delete(ctx, name, kind)
+}
+
+// URL implements Storage. It returns "" if opts describe an invalid URL;
+// call SignedURL directly if you need the error.
+func (c *Cloudinary) URL(name string, opts URLOptions) string {
+	u, err := c.SignedURL(name, opts)
+	if err != nil {
+		return ""
+	}
+	return u
+}
+
+var _ Storage = (*Cloudinary)(nil)
@@ -0,0 +1,68 @@
+// Package local implements cloudinary.Storage on the local filesystem, for
+// development and tests that shouldn't have to reach a real provider.
+package local
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cloudinary "github.com/alioygur/cloudinary-go"
+)
+
+// Storage stores assets as files under Dir and serves URLs rooted at
+// BaseURL, e.g. New("/var/assets", "http://localhost:8080/assets").
+type Storage struct {
+	Dir     string
+	BaseURL string
+}
+
+// New returns a Storage rooted at dir, serving URLs under baseURL.
+func New(dir, baseURL string) *Storage {
+	return &Storage{Dir: dir, BaseURL: baseURL}
+}
+
+// Upload writes r to Dir/name.
+func (s *Storage) Upload(ctx context.Context, r io.Reader, name string, kind cloudinary.UploadType) (*cloudinary.UploadResponse, error) {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(filepath.Join(s.Dir, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudinary.UploadResponse{
+		PublicID:     name,
+		ResourceType: string(kind),
+		Bytes:        int(n),
+		URL:          s.URL(name, cloudinary.URLOptions{}),
+	}, nil
+}
+
+// Delete removes Dir/name. Deleting a name that doesn't exist is not an
+// error, matching Cloudinary's own destroy semantics.
+func (s *Storage) Delete(ctx context.Context, name string, kind cloudinary.UploadType) error {
+	err := os.Remove(filepath.Join(s.Dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// URL returns BaseURL joined with name. opts is unused; local storage has
+// no transformation pipeline.
+func (s *Storage) URL(name string, opts cloudinary.URLOptions) string {
+	return strings.TrimRight(s.BaseURL, "/") + "/" + name
+}
+
+var _ cloudinary.Storage = (*Storage)(nil)
@@ -0,0 +1,48 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cloudinary "github.com/alioygur/cloudinary-go"
+)
+
+func TestStorage_UploadDelete(t *testing.T) {
+	dir := t.TempDir()
+	s := New(dir, "http://localhost:8080/assets")
+
+	res, err := s.Upload(context.Background(), strings.NewReader("hello"), "sample.txt", cloudinary.ImageType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.PublicID != "sample.txt" {
+		t.Errorf("PublicID = %v, want sample.txt", res.PublicID)
+	}
+	if res.Bytes != 5 {
+		t.Errorf("Bytes = %v, want 5", res.Bytes)
+	}
+	if want := "http://localhost:8080/assets/sample.txt"; res.URL != want {
+		t.Errorf("URL = %v, want %v", res.URL, want)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "sample.txt")); err != nil {
+		t.Errorf("file was not written: %v", err)
+	}
+
+	if err := s.Delete(context.Background(), "sample.txt", cloudinary.ImageType); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "sample.txt")); !os.IsNotExist(err) {
+		t.Errorf("file still exists after Delete")
+	}
+
+	// deleting an already-absent name is not an error
+	if err := s.Delete(context.Background(), "sample.txt", cloudinary.ImageType); err != nil {
+		t.Errorf("Delete() on missing file = %v, want nil", err)
+	}
+}
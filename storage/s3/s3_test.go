@@ -0,0 +1,54 @@
+package s3
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	cloudinary "github.com/alioygur/cloudinary-go"
+)
+
+func TestStorage_URL(t *testing.T) {
+	s := New("my-bucket", "us-east-1", "s3.amazonaws.com", "AKIA...", "secret")
+
+	got := s.URL("sample.jpg", cloudinary.URLOptions{})
+	want := "https://my-bucket.s3.amazonaws.com/sample.jpg"
+	if got != want {
+		t.Errorf("URL() = %v, want %v", got, want)
+	}
+}
+
+func TestEncodePath(t *testing.T) {
+	got := encodePath("a folder/my file.jpg")
+	want := "a%20folder/my%20file.jpg"
+	if got != want {
+		t.Errorf("encodePath() = %v, want %v", got, want)
+	}
+}
+
+func TestStorage_newRequest(t *testing.T) {
+	s := New("my-bucket", "us-east-1", "s3.amazonaws.com", "AKIA...", "secret")
+	s.Secure = false
+
+	req, err := s.newRequest(context.Background(), http.MethodPut, "a folder/my file.jpg", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "http://my-bucket.s3.amazonaws.com/a%20folder/my%20file.jpg"; req.URL.String() != want {
+		t.Errorf("newRequest() url = %v, want %v", req.URL.String(), want)
+	}
+}
+
+func TestSigningKey_Deterministic(t *testing.T) {
+	a := signingKey("secret", "20230101", "us-east-1")
+	b := signingKey("secret", "20230101", "us-east-1")
+	if string(a) != string(b) {
+		t.Error("signingKey() is not deterministic for the same inputs")
+	}
+
+	c := signingKey("other-secret", "20230101", "us-east-1")
+	if string(a) == string(c) {
+		t.Error("signingKey() did not change with a different secret")
+	}
+}
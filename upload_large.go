@@ -0,0 +1,209 @@
+package cloudinary
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxChunkRetries is how many times a single chunk is retried after a 5xx
+// response before UploadLarge gives up. The upload id lets Cloudinary
+// reassemble the asset from whichever chunks already landed, so retrying a
+// chunk never requires restarting the whole transfer.
+const maxChunkRetries = 3
+
+// UploadLarge uploads r using Cloudinary's chunked upload protocol, sending
+// it as a sequence of chunkSize blocks under a single X-Unique-Upload-Id.
+// Use this instead of the single-POST upload for videos and other assets
+// that may exceed Cloudinary's 100MB request limit.
+//
+// r is streamed chunkSize bytes at a time rather than read into memory up
+// front, so uploading a multi-gigabyte file only holds a couple of
+// chunkSize-sized buffers at once. The tradeoff is that the overall size
+// isn't known until the final chunk is read, so every chunk but the last
+// reports its Content-Range total as "*" rather than the real byte count;
+// this is a known, unverified constraint of streaming from an arbitrary
+// io.Reader and should be confirmed against the live API before relying on
+// it for very large, slow uploads.
+//
+// Intermediate chunks report done=false and are discarded; the
+// UploadResponse from the terminal chunk is returned.
+func (c *Cloudinary) UploadLarge(r io.Reader, name string, chunkSize int64, ut UploadType) (*UploadResponse, error) {
+	return c.UploadLargeContext(context.Background(), r, name, chunkSize, ut)
+}
+
+// UploadLargeContext is UploadLarge with a caller-supplied context, so the
+// upload can be cancelled or bound to a deadline.
+func (c *Cloudinary) UploadLargeContext(ctx context.Context, r io.Reader, name string, chunkSize int64, ut UploadType) (*UploadResponse, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("chunkSize must be positive")
+	}
+
+	uploadID, err := newUniqueUploadID()
+	if err != nil {
+		return nil, err
+	}
+
+	// cur/next ping-pong between these two buffers for the life of the
+	// upload: reading one chunk ahead is how we learn, without buffering
+	// the whole file, whether cur is the last chunk (a short read only
+	// proves that when the source length isn't an exact multiple of
+	// chunkSize).
+	bufA := make([]byte, chunkSize)
+	bufB := make([]byte, chunkSize)
+	cur, next := bufA, bufB
+
+	curLen, rerr := io.ReadFull(r, cur)
+	if rerr != nil && rerr != io.ErrUnexpectedEOF && rerr != io.EOF {
+		return nil, errors.WithStack(rerr)
+	}
+	curFinal := rerr == io.ErrUnexpectedEOF || rerr == io.EOF
+
+	var result *UploadResponse
+	var start int64
+	for {
+		nextLen := 0
+		if !curFinal {
+			nextLen, rerr = io.ReadFull(r, next)
+			if rerr != nil && rerr != io.ErrUnexpectedEOF && rerr != io.EOF {
+				return nil, errors.WithStack(rerr)
+			}
+			curFinal = nextLen == 0
+		}
+
+		end := start + int64(curLen) - 1
+		total := int64(-1)
+		if curFinal {
+			total = start + int64(curLen)
+		}
+
+		result, err = c.uploadChunk(ctx, cur[:curLen], name, ut, uploadID, start, end, total)
+		if err != nil {
+			return nil, err
+		}
+
+		if curFinal {
+			return result, nil
+		}
+
+		start += int64(curLen)
+		cur, next = next, cur
+		curLen = nextLen
+		curFinal = nextLen < len(next)
+	}
+}
+
+// uploadChunk POSTs a single chunk of a chunked upload, retrying on 5xx
+// responses since partial progress is preserved server-side by uploadID.
+// total is the overall upload size, or -1 if it isn't known yet (reported
+// to Cloudinary as an open-ended "*" range).
+func (c *Cloudinary) uploadChunk(ctx context.Context, chunk []byte, name string, ut UploadType, uploadID string, start, end, total int64) (*UploadResponse, error) {
+	var lastErr error
+	for attempt := 0; attempt <= maxChunkRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(time.Duration(attempt) * time.Second):
+			case <-ctx.Done():
+				return nil, errors.WithStack(ctx.Err())
+			}
+		}
+
+		result, status, err := c.doUploadChunk(ctx, chunk, name, ut, uploadID, start, end, total)
+		if err == nil {
+			return result, nil
+		}
+		if status < 500 || status > 599 {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// doUploadChunk performs a single attempt at sending one chunk and returns
+// the HTTP status code alongside any error so the caller can decide whether
+// the attempt is worth retrying.
+func (c *Cloudinary) doUploadChunk(ctx context.Context, chunk []byte, name string, ut UploadType, uploadID string, start, end, total int64) (*UploadResponse, int, error) {
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	params := map[string]string{"timestamp": timestamp}
+	if name != "" {
+		params["public_id"] = name
+	}
+
+	for field, value := range params {
+		if err := w.WriteField(field, value); err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+	}
+
+	if err := w.WriteField("api_key", c.apiKey); err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	if err := w.WriteField("signature", c.signParams(params)); err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	fw, err := w.CreateFormFile("file", "file")
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	if _, err := fw.Write(chunk); err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	totalStr := "*"
+	if total >= 0 {
+		totalStr = strconv.FormatInt(total, 10)
+	}
+
+	uri := fmt.Sprintf(baseURL, c.cloudName, ut, "upload")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, buf)
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Header.Set("X-Unique-Upload-Id", uploadID)
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%s", start, end, totalStr))
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	status := res.StatusCode
+	result := new(UploadResponse)
+	if err := unmarshalResponse(res, result); err != nil {
+		return nil, status, err
+	}
+
+	return result, status, nil
+}
+
+// newUniqueUploadID generates the value sent as X-Unique-Upload-Id, which
+// Cloudinary uses to associate the chunks of a single chunked upload.
+func newUniqueUploadID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(b), nil
+}
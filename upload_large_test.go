@@ -0,0 +1,66 @@
+package cloudinary
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// newTestUploadLargeClient returns a Cloudinary client whose RoundTripper
+// records the Content-Range header of every request it sees and replies
+// with a minimal successful UploadResponse.
+func newTestUploadLargeClient(ranges *[]string) *Cloudinary {
+	client := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			*ranges = append(*ranges, r.Header.Get("Content-Range"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"public_id":"sample"}`)),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	return &Cloudinary{cloudName: "demo", apiKey: "apikey", apiSecret: "apisecret", httpClient: client}
+}
+
+func TestCloudinary_UploadLargeContext(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		chunkSize int64
+		wantRange []string
+	}{
+		{"exact multiple of chunkSize", "abcdefgh", 4, []string{"bytes 0-3/*", "bytes 4-7/8"}},
+		{"short final chunk", "abcdef", 4, []string{"bytes 0-3/*", "bytes 4-5/6"}},
+		{"single short chunk", "abc", 4, []string{"bytes 0-2/3"}},
+		{"empty reader", "", 4, []string{"bytes 0--1/0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ranges []string
+			c := newTestUploadLargeClient(&ranges)
+
+			res, err := c.UploadLargeContext(context.Background(), bytes.NewReader([]byte(tt.source)), "sample", tt.chunkSize, ImageType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.PublicID != "sample" {
+				t.Errorf("PublicID = %v, want sample", res.PublicID)
+			}
+
+			if len(ranges) != len(tt.wantRange) {
+				t.Fatalf("sent %d chunks %v, want %d chunks %v", len(ranges), ranges, len(tt.wantRange), tt.wantRange)
+			}
+			for i, want := range tt.wantRange {
+				if ranges[i] != want {
+					t.Errorf("chunk %d Content-Range = %v, want %v", i, ranges[i], want)
+				}
+			}
+		})
+	}
+}
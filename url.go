@@ -0,0 +1,159 @@
+package cloudinary
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// DeliveryType is how the asset is served: upload, private or authenticated.
+	DeliveryType string
+
+	// Transformation describes a single set of image/video transformation
+	// parameters, e.g. crop+resize+quality. Chain several Transformations in
+	// URLOptions.Transformations to apply them in order.
+	Transformation struct {
+		Crop    string // c_ e.g. fill, fit, scale, crop
+		Width   int    // w_
+		Height  int    // h_
+		Gravity string // g_ e.g. face, center
+		Quality string // q_ e.g. auto, 80
+		Effect  string // e_ e.g. sepia, grayscale
+	}
+
+	// URLOptions controls how SignedURL builds a delivery URL.
+	URLOptions struct {
+		// ResourceType is image, video or raw. Defaults to ImageType.
+		ResourceType UploadType
+		// Type is the delivery type: upload, private or authenticated.
+		// Defaults to "upload".
+		Type DeliveryType
+		// Version, when non-zero, is rendered as /v<version>/ in the URL.
+		Version uint
+		// Transformations are applied in order, each chained with "/".
+		Transformations []Transformation
+		// Format is the file extension appended to the public id, e.g. "jpg".
+		Format string
+		// Secure selects https (res.cloudinary.com) over http.
+		Secure bool
+		// Signed includes the authenticated "s--XXXXXXXX--" signature
+		// component so the URL can't be tampered with.
+		Signed bool
+	}
+)
+
+// Delivery types
+const (
+	UploadDelivery        DeliveryType = "upload"
+	PrivateDelivery       DeliveryType = "private"
+	AuthenticatedDelivery DeliveryType = "authenticated"
+)
+
+// String renders the transformation as a Cloudinary URL component, e.g.
+// "c_fill,w_100,h_100,q_auto". Empty fields are omitted.
+func (t Transformation) String() string {
+	parts := make([]string, 0, 5)
+	if t.Crop != "" {
+		parts = append(parts, "c_"+t.Crop)
+	}
+	if t.Width != 0 {
+		parts = append(parts, "w_"+strconv.Itoa(t.Width))
+	}
+	if t.Height != 0 {
+		parts = append(parts, "h_"+strconv.Itoa(t.Height))
+	}
+	if t.Gravity != "" {
+		parts = append(parts, "g_"+t.Gravity)
+	}
+	if t.Quality != "" {
+		parts = append(parts, "q_"+t.Quality)
+	}
+	if t.Effect != "" {
+		parts = append(parts, "e_"+t.Effect)
+	}
+	return strings.Join(parts, ",")
+}
+
+// SignedURL builds a delivery URL for publicID, including any requested
+// transformations. If opts.Signed is set the URL includes an authenticated
+// "s--XXXXXXXX--" signature component, computed from the first 8 hex chars
+// of sha1(transformation+public_id+api_secret), so the URL can't be altered
+// or reused for another asset.
+func (c *Cloudinary) SignedURL(publicID string, opts URLOptions) (string, error) {
+	resourceType := opts.ResourceType
+	if resourceType == "" {
+		resourceType = ImageType
+	}
+
+	deliveryType := opts.Type
+	if deliveryType == "" {
+		deliveryType = UploadDelivery
+	}
+
+	transformation := transformationChain(opts.Transformations)
+
+	segments := []string{string(resourceType), string(deliveryType)}
+
+	if opts.Signed {
+		hash := sha1.New()
+		part := fmt.Sprintf("%s%s%s", transformation, publicID, c.apiSecret)
+		if _, err := io.WriteString(hash, part); err != nil {
+			return "", errors.WithStack(err)
+		}
+		sig := fmt.Sprintf("%x", hash.Sum(nil))[:8]
+		segments = append(segments, "s--"+sig+"--")
+	}
+
+	if transformation != "" {
+		segments = append(segments, transformation)
+	}
+
+	if opts.Version != 0 {
+		segments = append(segments, "v"+strconv.FormatUint(uint64(opts.Version), 10))
+	}
+
+	file := publicID
+	if opts.Format != "" {
+		file = file + "." + opts.Format
+	}
+	segments = append(segments, file)
+
+	scheme := "http"
+	if opts.Secure {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://res.cloudinary.com/%s/%s", scheme, c.cloudName, strings.Join(segments, "/")), nil
+}
+
+// transformationChain joins a sequence of transformations into a single
+// "/"-separated URL component, e.g. "c_fill,w_100/e_sepia".
+func transformationChain(ts []Transformation) string {
+	parts := make([]string, 0, len(ts))
+	for _, t := range ts {
+		if s := t.String(); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// eagerTransformationChain joins a sequence of transformations for the
+// upload "eager" parameter, where each entry is a distinct derived asset
+// generated at upload time. Cloudinary separates these with "|", unlike the
+// "/" chaining used to apply transformations in sequence within a single
+// delivery URL.
+func eagerTransformationChain(ts []Transformation) string {
+	parts := make([]string, 0, len(ts))
+	for _, t := range ts {
+		if s := t.String(); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "|")
+}
@@ -0,0 +1,56 @@
+package cloudinary
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCloudinary_SignedURL(t *testing.T) {
+	c := &Cloudinary{"demo", "apikey", "apisecret", http.DefaultClient}
+
+	t.Run("basic", func(t *testing.T) {
+		got, err := c.SignedURL("sample", URLOptions{Format: "jpg"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "http://res.cloudinary.com/demo/image/upload/sample.jpg"
+		if got != want {
+			t.Errorf("SignedURL() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("with transformation and version", func(t *testing.T) {
+		got, err := c.SignedURL("sample", URLOptions{
+			Version:         123,
+			Transformations: []Transformation{{Crop: "fill", Width: 100, Height: 200}},
+			Format:          "jpg",
+			Secure:          true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "https://res.cloudinary.com/demo/image/upload/c_fill,w_100,h_200/v123/sample.jpg"
+		if got != want {
+			t.Errorf("SignedURL() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		got, err := c.SignedURL("sample", URLOptions{Signed: true, Format: "jpg"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(got, "/s--") {
+			t.Errorf("SignedURL() = %v, want signature component", got)
+		}
+	})
+}
+
+func TestEagerTransformationChain(t *testing.T) {
+	got := eagerTransformationChain([]Transformation{{Crop: "fill", Width: 100}, {Effect: "sepia"}})
+	want := "c_fill,w_100|e_sepia"
+	if got != want {
+		t.Errorf("eagerTransformationChain() = %v, want %v", got, want)
+	}
+}